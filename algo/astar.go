@@ -0,0 +1,88 @@
+package algo
+
+import (
+	"container/heap"
+
+	adigo "adigo/internal"
+)
+
+// AStar searches g for a shortest path from start to goal using the heuristic h,
+// which must be admissible (it must never overestimate the true remaining cost to
+// goal) for the returned path to be optimal. Edges with no weight set (see
+// ADIGraph.SetWeight) are treated as having weight 1.
+//
+// It returns the path from start to goal inclusive, the path's total cost, and
+// whether goal was reached at all.
+func AStar(g adigo.ADIGraph, start, goal adigo.ADINode, h func(adigo.ADINode) float64) ([]adigo.ADINode, float64, bool) {
+	gScore := map[string]float64{start.Label(): 0}
+	prev := map[string]string{}
+	visited := map[string]bool{}
+
+	pq := &priorityQueue{{label: start.Label(), priority: h(start)}}
+	heap.Init(pq)
+
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(*item)
+		if visited[cur.label] {
+			continue
+		}
+		if cur.label == goal.Label() {
+			return reconstructPath(g, prev, start, goal), gScore[cur.label], true
+		}
+		visited[cur.label] = true
+
+		u, err := g.GetByLabel(cur.label)
+		if err != nil {
+			continue
+		}
+		uLoc, lerr := g.GetLocatorsByLabel(cur.label)
+		if lerr != nil {
+			continue
+		}
+
+		for _, v := range g.Neighbors(u) {
+			if visited[v.Label()] {
+				continue
+			}
+			vLoc, lerr := g.GetLocatorsByLabel(v.Label())
+			if lerr != nil {
+				continue
+			}
+
+			tentative := gScore[cur.label] + g.Weight(uLoc, vLoc)
+			if existing, ok := gScore[v.Label()]; !ok || tentative < existing {
+				gScore[v.Label()] = tentative
+				prev[v.Label()] = cur.label
+				heap.Push(pq, &item{label: v.Label(), priority: tentative + h(v)})
+			}
+		}
+	}
+
+	return nil, 0, false
+}
+
+// reconstructPath walks prev back from goal to start, returning the path in
+// traversal order.
+func reconstructPath(g adigo.ADIGraph, prev map[string]string, start, goal adigo.ADINode) []adigo.ADINode {
+	var path []adigo.ADINode
+	cur := goal.Label()
+
+	for {
+		node, err := g.GetByLabel(cur)
+		if err != nil {
+			break
+		}
+		path = append([]adigo.ADINode{node}, path...)
+		if cur == start.Label() {
+			break
+		}
+
+		parent, ok := prev[cur]
+		if !ok {
+			break
+		}
+		cur = parent
+	}
+
+	return path
+}
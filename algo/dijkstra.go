@@ -0,0 +1,106 @@
+// Package algo implements graph algorithms on top of an adigo.ADIGraph: shortest
+// paths (Dijkstra, A*) and, eventually, other classic analyses that don't belong in
+// the graph's core CRUD surface.
+package algo
+
+import (
+	"container/heap"
+	"errors"
+
+	adigo "adigo/internal"
+)
+
+var errNegativeWeight = errors.New("algo: negative edge weight encountered; use Bellman-Ford instead")
+
+// item is an entry in the Dijkstra/A* priority queue.
+type item struct {
+	label    string
+	priority float64
+	index    int
+}
+
+// priorityQueue is a container/heap min-heap keyed on priority. Stale entries left
+// behind by a decrease-key (a node pushed more than once with a smaller priority)
+// are skipped on pop rather than removed in place.
+type priorityQueue []*item
+
+func (pq priorityQueue) Len() int            { return len(pq) }
+func (pq priorityQueue) Less(i, j int) bool  { return pq[i].priority < pq[j].priority }
+func (pq priorityQueue) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+	pq[i].index = i
+	pq[j].index = j
+}
+
+func (pq *priorityQueue) Push(x interface{}) {
+	it := x.(*item)
+	it.index = len(*pq)
+	*pq = append(*pq, it)
+}
+
+func (pq *priorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	it := old[n-1]
+	old[n-1] = nil
+	it.index = -1
+	*pq = old[:n-1]
+	return it
+}
+
+// Dijkstra computes single-source shortest paths from start to every node
+// reachable from it in g, using the per-edge weights stored on g via
+// ADIGraph.SetWeight. Edges with no weight set are treated as having weight 1.
+//
+// It returns the distance to each reachable node keyed by label, and a prev map
+// recording the label used to reach each key — walk prev back from a goal label to
+// start to reconstruct the path. Dijkstra returns an error if it encounters a
+// negative edge weight.
+func Dijkstra(g adigo.ADIGraph, start adigo.ADINode) (dist map[string]float64, prev map[string]string, err error) {
+	dist = map[string]float64{start.Label(): 0}
+	prev = map[string]string{}
+	visited := map[string]bool{}
+
+	pq := &priorityQueue{{label: start.Label(), priority: 0}}
+	heap.Init(pq)
+
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(*item)
+		if visited[cur.label] {
+			continue
+		}
+		visited[cur.label] = true
+
+		u, uerr := g.GetByLabel(cur.label)
+		if uerr != nil {
+			continue
+		}
+		uLoc, lerr := g.GetLocatorsByLabel(cur.label)
+		if lerr != nil {
+			continue
+		}
+
+		for _, v := range g.Neighbors(u) {
+			if visited[v.Label()] {
+				continue
+			}
+			vLoc, lerr := g.GetLocatorsByLabel(v.Label())
+			if lerr != nil {
+				continue
+			}
+			w := g.Weight(uLoc, vLoc)
+			if w < 0 {
+				return nil, nil, errNegativeWeight
+			}
+
+			next := dist[cur.label] + w
+			if existing, ok := dist[v.Label()]; !ok || next < existing {
+				dist[v.Label()] = next
+				prev[v.Label()] = cur.label
+				heap.Push(pq, &item{label: v.Label(), priority: next})
+			}
+		}
+	}
+
+	return dist, prev, nil
+}
@@ -0,0 +1,111 @@
+package algo
+
+import (
+	"testing"
+
+	adigo "adigo/internal"
+)
+
+// newTestNode returns a labeled Box with one column pre-allocated; see the
+// identical helper in the internal package test for why this is needed for any
+// graph that stays within a single word-size boundary.
+func newTestNode(label string) *adigo.Box {
+	b := &adigo.Box{}
+	b.SetLabel(label)
+	b.AddColumn()
+	return b
+}
+
+// divergentWeightGraph builds S -> A -> C (cost 1 + 5 = 6) and S -> B -> C
+// (cost 10 + 1 = 11), so the true shortest distance to C is 6, via A. The two
+// in-edges into C carry different weights on purpose: if a target's weight
+// collapsed to a single value keyed only by C (rather than by the (source,
+// target) pair), whichever SetWeight call for C ran last would silently apply
+// to both paths and the algorithms would compute the wrong distance.
+func divergentWeightGraph(t *testing.T) adigo.ADIGraph {
+	t.Helper()
+
+	g := adigo.NewGraph()
+	s, a, b, c := newTestNode("S"), newTestNode("A"), newTestNode("B"), newTestNode("C")
+	g.AddNode(s)
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+
+	g.Connect("S", "A", "B")
+	g.Connect("A", "C")
+	g.Connect("B", "C")
+
+	sLoc, _ := g.GetLocatorsByLabel("S")
+	aLoc, _ := g.GetLocatorsByLabel("A")
+	bLoc, _ := g.GetLocatorsByLabel("B")
+	cLoc, _ := g.GetLocatorsByLabel("C")
+
+	// SetWeight has a pointer receiver, so these calls must run against &g
+	// directly rather than through a helper taking ADIGraph by value - passing
+	// the graph by value anywhere before this point would silently write the
+	// weights into a throwaway copy's map instead of g's.
+	for _, e := range []struct {
+		from, to adigo.Locator
+		w        float64
+	}{
+		{sLoc, aLoc, 1},
+		{sLoc, bLoc, 10},
+		{aLoc, cLoc, 5},
+		{bLoc, cLoc, 1},
+	} {
+		if err := g.SetWeight(e.from, e.to, e.w); err != nil {
+			t.Fatalf("SetWeight(%v, %v, %v) returned error: %v", e.from, e.to, e.w, err)
+		}
+	}
+
+	return g
+}
+
+func TestDijkstraUsesPerEdgeWeights(t *testing.T) {
+	g := divergentWeightGraph(t)
+	start, _ := g.GetByLabel("S")
+
+	dist, prev, err := Dijkstra(g, start)
+	if err != nil {
+		t.Fatalf("Dijkstra returned error: %v", err)
+	}
+
+	// S -> A -> C costs 1 + 5 = 6, cheaper than S -> B -> C at 10 + 1 = 11.
+	if got, want := dist["C"], 6.0; got != want {
+		t.Errorf("dist[C] = %v, want %v", got, want)
+	}
+	if got, want := prev["C"], "A"; got != want {
+		t.Errorf("prev[C] = %q, want %q", got, want)
+	}
+}
+
+func TestAStarUsesPerEdgeWeights(t *testing.T) {
+	g := divergentWeightGraph(t)
+	start, _ := g.GetByLabel("S")
+	goal, _ := g.GetByLabel("C")
+
+	zero := func(adigo.ADINode) float64 { return 0 }
+
+	path, cost, found := AStar(g, start, goal, zero)
+	if !found {
+		t.Fatal("AStar did not find a path from S to C")
+	}
+	if got, want := cost, 6.0; got != want {
+		t.Errorf("cost = %v, want %v", got, want)
+	}
+
+	var labels []string
+	for _, n := range path {
+		labels = append(labels, n.Label())
+	}
+	want := []string{"S", "A", "C"}
+	if len(labels) != len(want) {
+		t.Fatalf("path = %v, want %v", labels, want)
+	}
+	for i := range want {
+		if labels[i] != want[i] {
+			t.Fatalf("path = %v, want %v", labels, want)
+		}
+	}
+}
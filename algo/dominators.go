@@ -0,0 +1,243 @@
+package algo
+
+import (
+	adigo "adigo/internal"
+)
+
+// DomTree is the dominator tree of a graph computed by Dominators, rooted at the
+// node passed to it.
+type DomTree struct {
+	graph    adigo.ADIGraph
+	root     string
+	idom     map[string]string
+	frontier map[string][]string
+}
+
+// Dominators computes the dominator tree of g reachable from root, using the
+// "simple" (path-compression, no balanced-forest) variant of the
+// Lengauer-Tarjan algorithm.
+//
+// It runs a DFS from root over g.Neighbors to assign each reachable node a
+// preorder number and a DFS parent, builds the transpose adjacency once up front
+// (predecessors are expensive to recover from the ADI bitset layout directly),
+// then processes nodes in reverse preorder: for each predecessor u of w, it
+// evaluates u through the ancestor/label path-compression structure to find the
+// vertex with minimum semidominator on u's path to the forest root, tightening
+// semi[w] accordingly, before linking w under its DFS parent. A second forward
+// pass resolves the deferred bucket condition into true immediate dominators.
+// Dominance frontiers are then derived from idom with the standard
+// Cooper-Harvey-Kennedy walk. Nodes unreachable from root are absent from the
+// tree entirely.
+func Dominators(g adigo.ADIGraph, root adigo.ADINode) *DomTree {
+	vertex, number, parentLabel := dfsPreorder(g, root)
+	n := len(vertex)
+
+	parentIdx := make([]int, n)
+	for i, lbl := range vertex {
+		if p, ok := parentLabel[lbl]; ok {
+			parentIdx[i] = number[p]
+		} else {
+			parentIdx[i] = 0
+		}
+	}
+
+	preds := transposePreds(g, vertex, number)
+
+	semi := make([]int, n)
+	ancestor := make([]int, n)
+	compLabel := make([]int, n)
+	bucket := make([][]int, n)
+	idomIdx := make([]int, n)
+	for i := range semi {
+		semi[i] = i
+		ancestor[i] = -1
+		compLabel[i] = i
+	}
+
+	var compress func(v int)
+	compress = func(v int) {
+		if ancestor[ancestor[v]] == -1 {
+			return
+		}
+		compress(ancestor[v])
+		if semi[compLabel[ancestor[v]]] < semi[compLabel[v]] {
+			compLabel[v] = compLabel[ancestor[v]]
+		}
+		ancestor[v] = ancestor[ancestor[v]]
+	}
+
+	eval := func(v int) int {
+		if ancestor[v] == -1 {
+			return v
+		}
+		compress(v)
+		return compLabel[v]
+	}
+
+	for w := n - 1; w >= 1; w-- {
+		for _, u := range preds[w] {
+			if u2 := eval(u); semi[u2] < semi[w] {
+				semi[w] = semi[u2]
+			}
+		}
+		bucket[semi[w]] = append(bucket[semi[w]], w)
+		ancestor[w] = parentIdx[w]
+
+		for _, v := range bucket[parentIdx[w]] {
+			u := eval(v)
+			if semi[u] < semi[v] {
+				idomIdx[v] = u
+			} else {
+				idomIdx[v] = parentIdx[w]
+			}
+		}
+		bucket[parentIdx[w]] = nil
+	}
+
+	for w := 1; w < n; w++ {
+		if idomIdx[w] != semi[w] {
+			idomIdx[w] = idomIdx[idomIdx[w]]
+		}
+	}
+
+	idom := make(map[string]string, n-1)
+	for i := 1; i < n; i++ {
+		idom[vertex[i]] = vertex[idomIdx[i]]
+	}
+
+	return &DomTree{
+		graph:    g,
+		root:     root.Label(),
+		idom:     idom,
+		frontier: dominanceFrontier(vertex, preds, idomIdx),
+	}
+}
+
+// dfsPreorder walks g from root via Neighbors, assigning each reachable node a
+// preorder number. vertex maps a preorder number back to its label, number is
+// its inverse, and parent records the DFS parent of every non-root node.
+func dfsPreorder(g adigo.ADIGraph, root adigo.ADINode) (vertex []string, number map[string]int, parent map[string]string) {
+	number = map[string]int{}
+	parent = map[string]string{}
+
+	var visit func(label string)
+	visit = func(label string) {
+		if _, seen := number[label]; seen {
+			return
+		}
+		number[label] = len(vertex)
+		vertex = append(vertex, label)
+
+		node, err := g.GetByLabel(label)
+		if err != nil {
+			return
+		}
+		for _, nb := range g.Neighbors(node) {
+			if _, seen := number[nb.Label()]; !seen {
+				parent[nb.Label()] = label
+				visit(nb.Label())
+			}
+		}
+	}
+	visit(root.Label())
+
+	return vertex, number, parent
+}
+
+// transposePreds builds the reverse adjacency of the subgraph reachable from
+// root, indexed by preorder number, so Dominators can look up predecessors
+// without a reverse bitscan per query.
+func transposePreds(g adigo.ADIGraph, vertex []string, number map[string]int) [][]int {
+	preds := make([][]int, len(vertex))
+	for _, label := range vertex {
+		node, err := g.GetByLabel(label)
+		if err != nil {
+			continue
+		}
+		u := number[label]
+		for _, nb := range g.Neighbors(node) {
+			if w, ok := number[nb.Label()]; ok {
+				preds[w] = append(preds[w], u)
+			}
+		}
+	}
+	return preds
+}
+
+// dominanceFrontier computes the dominance frontier of every reachable node
+// from idomIdx (keyed by preorder number) using the Cooper-Harvey-Kennedy walk:
+// for a node w with more than one predecessor, each predecessor climbs its idom
+// chain, adding w to the frontier of every node visited before reaching idom[w].
+func dominanceFrontier(vertex []string, preds [][]int, idomIdx []int) map[string][]string {
+	frontier := make(map[string][]string)
+
+	for w := 1; w < len(vertex); w++ {
+		if len(preds[w]) < 2 {
+			continue
+		}
+		for _, p := range preds[w] {
+			for runner := p; runner != idomIdx[w]; {
+				frontier[vertex[runner]] = append(frontier[vertex[runner]], vertex[w])
+				if runner == 0 {
+					break
+				}
+				runner = idomIdx[runner]
+			}
+		}
+	}
+
+	return frontier
+}
+
+// ImmediateDominator returns n's immediate dominator, or nil if n is the tree's
+// root or wasn't reachable from it.
+func (t *DomTree) ImmediateDominator(n adigo.ADINode) adigo.ADINode {
+	parent, ok := t.idom[n.Label()]
+	if !ok {
+		return nil
+	}
+	node, err := t.graph.GetByLabel(parent)
+	if err != nil {
+		return nil
+	}
+	return node
+}
+
+// DominatorsOf returns every node that dominates n, starting with n itself and
+// ending with the tree's root.
+func (t *DomTree) DominatorsOf(n adigo.ADINode) []adigo.ADINode {
+	var path []adigo.ADINode
+
+	for cur := n.Label(); ; {
+		node, err := t.graph.GetByLabel(cur)
+		if err != nil {
+			break
+		}
+		path = append(path, node)
+		if cur == t.root {
+			break
+		}
+
+		parent, ok := t.idom[cur]
+		if !ok {
+			break
+		}
+		cur = parent
+	}
+
+	return path
+}
+
+// DominanceFrontier returns the set of nodes n does not strictly dominate but
+// whose immediate predecessor n does dominate - the nodes where n's dominance
+// "stops".
+func (t *DomTree) DominanceFrontier(n adigo.ADINode) []adigo.ADINode {
+	labels := t.frontier[n.Label()]
+	nodes := make([]adigo.ADINode, 0, len(labels))
+	for _, label := range labels {
+		if node, err := t.graph.GetByLabel(label); err == nil {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
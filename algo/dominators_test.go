@@ -0,0 +1,62 @@
+package algo
+
+import (
+	"testing"
+
+	adigo "adigo/internal"
+)
+
+// disconnectedDomGraph builds root -> A, plus an isolated node D with no edge
+// from root at all, so D is unreachable from root.
+func disconnectedDomGraph(t *testing.T) adigo.ADIGraph {
+	t.Helper()
+
+	g := adigo.NewGraph()
+	root, a, d := newTestNode("root"), newTestNode("A"), newTestNode("D")
+	g.AddNode(root)
+	g.AddNode(a)
+	g.AddNode(d)
+
+	g.Connect("root", "A")
+
+	return g
+}
+
+func TestDominatorsOmitsUnreachableNodes(t *testing.T) {
+	g := disconnectedDomGraph(t)
+	root, _ := g.GetByLabel("root")
+	d, _ := g.GetByLabel("D")
+
+	tree := Dominators(g, root)
+
+	if idom := tree.ImmediateDominator(d); idom != nil {
+		t.Errorf("ImmediateDominator(D) = %v, want nil (D is unreachable from root)", idom)
+	}
+	// DominatorsOf always starts with n itself; since D has no idom entry (it
+	// was never reached from root), the walk stops immediately and D is the
+	// only element in the path.
+	if path := tree.DominatorsOf(d); len(path) != 1 || path[0].Label() != "D" {
+		t.Errorf("DominatorsOf(D) = %v, want [D] (D is unreachable from root, so the walk can't climb further)", path)
+	}
+	if frontier := tree.DominanceFrontier(d); len(frontier) != 0 {
+		t.Errorf("DominanceFrontier(D) = %v, want empty (D is unreachable from root)", frontier)
+	}
+}
+
+func TestDominatorsOfReachableNode(t *testing.T) {
+	g := disconnectedDomGraph(t)
+	root, _ := g.GetByLabel("root")
+	a, _ := g.GetByLabel("A")
+
+	tree := Dominators(g, root)
+
+	idom := tree.ImmediateDominator(a)
+	if idom == nil || idom.Label() != "root" {
+		t.Errorf("ImmediateDominator(A) = %v, want root", idom)
+	}
+
+	path := tree.DominatorsOf(a)
+	if len(path) != 2 || path[0].Label() != "A" || path[1].Label() != "root" {
+		t.Errorf("DominatorsOf(A) = %v, want [A root]", path)
+	}
+}
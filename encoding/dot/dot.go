@@ -0,0 +1,195 @@
+// Package dot renders an adigo.ADIGraph as GraphViz DOT, so a graph built with
+// adigo can be piped straight into `dot -Tsvg` (or similar tooling) without an
+// extra dependency like gonum.
+package dot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	adigo "adigo/internal"
+)
+
+// Option configures how a graph is marshaled to DOT.
+type Option func(*config)
+
+type config struct {
+	directed    bool
+	skipDeleted bool
+	nodeAttrs   func(adigo.ADINode) map[string]string
+	edgeAttrs   func(src, dst adigo.ADINode) map[string]string
+}
+
+// Directed renders the graph with directed edges ("->"). This is the default.
+func Directed() Option {
+	return func(c *config) { c.directed = true }
+}
+
+// Undirected renders the graph with undirected edges ("--").
+func Undirected() Option {
+	return func(c *config) { c.directed = false }
+}
+
+// SkipDeleted omits lazily-deleted nodes (and any edges into them) from the
+// output. Off by default.
+func SkipDeleted() Option {
+	return func(c *config) { c.skipDeleted = true }
+}
+
+// NodeAttrs supplies GraphViz attributes (color, shape, …) for each rendered node.
+func NodeAttrs(f func(adigo.ADINode) map[string]string) Option {
+	return func(c *config) { c.nodeAttrs = f }
+}
+
+// EdgeAttrs supplies GraphViz attributes (color, label, …) for each rendered edge.
+func EdgeAttrs(f func(src, dst adigo.ADINode) map[string]string) Option {
+	return func(c *config) { c.edgeAttrs = f }
+}
+
+// Marshal renders g as GraphViz DOT source.
+func Marshal(g adigo.ADIGraph, opts ...Option) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf, opts...).Encode(g); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encoder writes DOT-formatted output for an ADIGraph to an underlying writer.
+type Encoder struct {
+	w   io.Writer
+	cfg config
+}
+
+// NewEncoder returns an Encoder that writes to w, configured by opts. Graphs are
+// directed by default.
+func NewEncoder(w io.Writer, opts ...Option) *Encoder {
+	cfg := config{directed: true}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Encoder{w: w, cfg: cfg}
+}
+
+// Encode writes g to the Encoder's underlying writer as GraphViz DOT.
+func (e *Encoder) Encode(g adigo.ADIGraph) error {
+	edgeOp, graphKind := "->", "digraph"
+	if !e.cfg.directed {
+		edgeOp, graphKind = "--", "graph"
+	}
+
+	if _, err := fmt.Fprintf(e.w, "%s G {\n", graphKind); err != nil {
+		return err
+	}
+
+	// An undirected edge is stored in the ADI model as a set bit in both
+	// directions, so the bitscan below would otherwise visit it twice - once
+	// as "A" -- "B" and once as "B" -- "A". Track unordered pairs already
+	// written so each undirected edge is emitted once.
+	var seenUndirected map[string]bool
+	if !e.cfg.directed {
+		seenUndirected = make(map[string]bool)
+	}
+
+	wordSize := g.WordSize()
+	for i := 0; i < g.Size(); i++ {
+		src, err := g.GetByIndex(i)
+		if err != nil {
+			continue
+		}
+		if e.cfg.skipDeleted && src.Deleted() {
+			continue
+		}
+
+		if err := e.writeNode(src); err != nil {
+			return err
+		}
+
+		for col, adi := range src.Edges() {
+			for offset := 0; offset < wordSize; offset++ {
+				if adi&(1<<byte(offset)) == 0 {
+					continue
+				}
+
+				dst, err := g.GetByIndex(col*wordSize + offset)
+				if err != nil {
+					continue
+				}
+				if e.cfg.skipDeleted && dst.Deleted() {
+					continue
+				}
+
+				if seenUndirected != nil {
+					key := unorderedPairKey(src.Label(), dst.Label())
+					if seenUndirected[key] {
+						continue
+					}
+					seenUndirected[key] = true
+				}
+
+				if err := e.writeEdge(src, dst, edgeOp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	_, err := fmt.Fprint(e.w, "}\n")
+	return err
+}
+
+func (e *Encoder) writeNode(n adigo.ADINode) error {
+	if _, err := fmt.Fprintf(e.w, "\t%q", n.Label()); err != nil {
+		return err
+	}
+	if e.cfg.nodeAttrs != nil {
+		if err := writeAttrs(e.w, e.cfg.nodeAttrs(n)); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(e.w, ";\n")
+	return err
+}
+
+func (e *Encoder) writeEdge(src, dst adigo.ADINode, edgeOp string) error {
+	if _, err := fmt.Fprintf(e.w, "\t%q %s %q", src.Label(), edgeOp, dst.Label()); err != nil {
+		return err
+	}
+	if e.cfg.edgeAttrs != nil {
+		if err := writeAttrs(e.w, e.cfg.edgeAttrs(src, dst)); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(e.w, ";\n")
+	return err
+}
+
+func writeAttrs(w io.Writer, attrs map[string]string) error {
+	if len(attrs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(attrs))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, attrs[k]))
+	}
+	_, err := fmt.Fprintf(w, " [%s]", strings.Join(pairs, ", "))
+	return err
+}
+
+// unorderedPairKey canonicalizes a and b into a single key so an undirected
+// edge is recognized regardless of which direction's bit is scanned first.
+func unorderedPairKey(a, b string) string {
+	if a > b {
+		a, b = b, a
+	}
+	return a + "\x00" + b
+}
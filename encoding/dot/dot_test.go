@@ -0,0 +1,77 @@
+package dot
+
+import (
+	"strings"
+	"testing"
+
+	adigo "adigo/internal"
+)
+
+func newTestNode(label string) *adigo.Box {
+	b := &adigo.Box{}
+	b.SetLabel(label)
+	b.AddColumn()
+	return b
+}
+
+func TestWriteAttrsSortsKeys(t *testing.T) {
+	attrs := map[string]string{"shape": "box", "color": "red", "label": "A"}
+
+	// writeAttrs is package-internal, so build a graph just to exercise it
+	// through Encode's node-attribute callback and check the emitted order.
+	g := adigo.NewGraph()
+	a := newTestNode("A")
+	g.AddNode(a)
+
+	out, err := Marshal(g, NodeAttrs(func(adigo.ADINode) map[string]string {
+		return attrs
+	}))
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	want := `color="red", label="A", shape="box"`
+	if !strings.Contains(string(out), want) {
+		t.Errorf("Marshal output = %q, want it to contain sorted attrs %q", out, want)
+	}
+}
+
+func TestEncodeUndirectedDedupesEdges(t *testing.T) {
+	g := adigo.NewGraph()
+	a, b := newTestNode("A"), newTestNode("B")
+	g.AddNode(a)
+	g.AddNode(b)
+	// Connect both directions, the normal way to represent an undirected edge
+	// in this ADI model.
+	g.Connect("A", "B")
+	g.Connect("B", "A")
+
+	out, err := Marshal(g, Undirected())
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	count := strings.Count(string(out), "--")
+	if count != 1 {
+		t.Errorf("Marshal emitted %d undirected edge lines, want 1 (A--B and B--A should dedupe)", count)
+	}
+}
+
+func TestEncodeDirectedKeepsBothDirections(t *testing.T) {
+	g := adigo.NewGraph()
+	a, b := newTestNode("A"), newTestNode("B")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.Connect("A", "B")
+	g.Connect("B", "A")
+
+	out, err := Marshal(g, Directed())
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	count := strings.Count(string(out), "->")
+	if count != 2 {
+		t.Errorf("Marshal emitted %d directed edge lines, want 2 (directed mode must not dedupe)", count)
+	}
+}
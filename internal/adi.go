@@ -24,6 +24,7 @@ package adigo
 
 import (
 	"errors"
+	"math/bits"
 	"sync"
 )
 
@@ -37,6 +38,7 @@ var (
 	errGraphBoundsMismatch = errors.New("the number of nodes does not match the bounds of the ADIs")
 	errLabelNotFound       = errors.New("label not found")
 	errDeleted             = errors.New("node has been deleted")
+	errNegativeWeight      = errors.New("negative edge weights are not supported; use Bellman-Ford instead")
 )
 
 /*
@@ -52,6 +54,15 @@ type ADIGraph struct {
 	wordSize     int
 	nodes        []ADINode
 	labels       map[string]int
+	weights      map[edgeKey]float64
+
+	// freeList holds indices tombstoned by DeleteByIndex, available for AddNode to
+	// reuse before the node list grows. ids[i] is the stable NodeID of whatever
+	// node currently occupies slice position i, and slots is its inverse.
+	freeList []int
+	ids      []NodeID
+	slots    map[NodeID]int
+	nextID   NodeID
 }
 
 // Locator contains the column and offset used to identify a node in the graph.
@@ -60,6 +71,20 @@ type Locator struct {
 	offset byte
 }
 
+// edgeKey identifies a single directed edge by the locators of its source and
+// target, so a weight can be recorded per edge rather than per target bit
+// position. Keying by target alone would collapse every in-edge into this node
+// to one shared weight, which is wrong whenever a node has two differently
+// weighted predecessors.
+type edgeKey struct {
+	from, to Locator
+}
+
+// NodeID is an opaque, stable identifier for a node in an ADIGraph. Unlike a slice
+// index or Locator, a NodeID remains valid across calls to Compact, so external
+// callers can hold onto one safely instead of re-resolving a node by label.
+type NodeID int
+
 /*
 	-------
 	METHODS
@@ -71,6 +96,7 @@ func NewGraph() ADIGraph {
 	graph := ADIGraph{}
 	graph.wordSize = 8
 	graph.labels = make(map[string]int)
+	graph.slots = make(map[NodeID]int)
 	return graph
 }
 
@@ -118,6 +144,35 @@ func (g ADIGraph) Neighbors(n ADINode) []ADINode {
 	return results[:idx]
 }
 
+// NeighborsWithLabel returns n's neighbors reached via an edge carrying label. It
+// requires n to implement LabeledADINode; nodes that don't return nil. The
+// locators recorded under label are intersected with the ADI bitscan performed
+// by Neighbors, so a label entry left stale by a RemoveEdges call that bypassed
+// RemoveLabeledEdge is never surfaced as a neighbor.
+func (g ADIGraph) NeighborsWithLabel(n ADINode, label Label) []ADINode {
+	ln, ok := n.(LabeledADINode)
+	if !ok {
+		return nil
+	}
+
+	connected := map[string]bool{}
+	for _, nb := range g.Neighbors(n) {
+		connected[nb.Label()] = true
+	}
+
+	var results []ADINode
+	for _, loc := range ln.TargetsByLabel(label) {
+		index := loc.column*g.wordSize + bits.TrailingZeros8(loc.offset)
+		target, err := g.GetByIndex(index)
+		if err != nil || !connected[target.Label()] {
+			continue
+		}
+		results = append(results, target)
+	}
+
+	return results
+}
+
 // Connect takes the label of a node in the graph and connects it to any number of other nodes by label.
 func (g ADIGraph) Connect(label string, neighbors ...string) error {
 	item, err := g.GetByLabel(label)
@@ -142,22 +197,102 @@ func (g ADIGraph) Size() int {
 	return len(g.nodes)
 }
 
-// AddNode accepts an ADINode and adds it to the receiving ADIGraph. It returns a nil error
-// unless the node fails to be added.
-// If adding the node causes the size of the graph to be larger than the graph's word size,
-// the graph will grow a column to locate the new node within the existing nodes' lists of ADIs.
-func (g *ADIGraph) AddNode(n ADINode) error {
-	if len(g.nodes) > (g.wordSize-1) && (len(g.nodes)%g.wordSize) == 0 {
-		g.labels[n.Label()] = len(g.nodes)
+// WordSize returns the number of bits packed into each column of a node's ADIs.
+func (g ADIGraph) WordSize() int {
+	return g.wordSize
+}
+
+// SetWeight assigns the weight used when traversing the edge from the node
+// identified by from to the node identified by to. Edges with no weight set
+// default to 1 (see Weight). SetWeight returns errNegativeWeight if w is
+// negative, since the shortest-path algorithms in the algo package don't
+// support negative weights.
+func (g *ADIGraph) SetWeight(from, to Locator, w float64) error {
+	if w < 0 {
+		return errNegativeWeight
+	}
+	if g.weights == nil {
+		g.weights = make(map[edgeKey]float64)
+	}
+	g.weights[edgeKey{from: from, to: to}] = w
+	return nil
+}
+
+// Weight returns the weight of the edge from the node identified by from to the
+// node identified by to, or 1 if no weight has been set for it.
+func (g ADIGraph) Weight(from, to Locator) float64 {
+	if w, ok := g.weights[edgeKey{from: from, to: to}]; ok {
+		return w
+	}
+	return 1
+}
+
+// AddNode accepts an ADINode and adds it to the receiving ADIGraph, returning the
+// node's stable NodeID. It returns a nil error unless the node fails to be added.
+//
+// AddNode first looks for a slot tombstoned by DeleteByIndex/DeleteByLabel and
+// reuses it instead of growing the node list, clearing any stale edge bits left
+// over from the slot's previous occupant so the new node starts disconnected from
+// the rest of the graph. Only when there is no free slot, and adding the node
+// would push the graph past a word-size boundary, does the graph grow a column to
+// locate the new node within the existing nodes' lists of ADIs.
+func (g *ADIGraph) AddNode(n ADINode) (NodeID, error) {
+	var index int
+
+	if len(g.freeList) > 0 {
+		index = g.freeList[len(g.freeList)-1]
+		g.freeList = g.freeList[:len(g.freeList)-1]
+		g.clearIncomingEdges(index)
+		g.nodes[index] = n
+	} else {
+		index = len(g.nodes)
+		grow := index > (g.wordSize-1) && (index%g.wordSize) == 0
+
 		g.nodes = append(g.nodes, n)
-		g.Grow()
-		return nil
+		g.ids = append(g.ids, 0)
+
+		if grow {
+			g.Grow()
+		}
 	}
 
-	// Map the node's index to its label before appending to the node list.
-	g.labels[n.Label()] = len(g.nodes)
-	g.nodes = append(g.nodes, n)
-	return nil
+	id := g.nextID
+	g.nextID++
+	g.ids[index] = id
+	g.slots[id] = index
+	g.labels[n.Label()] = index
+
+	return id, nil
+}
+
+// clearIncomingEdges removes any leftover bit at index from every other node's
+// ADIs, and any weight recorded against an edge into or out of it, so a
+// tombstoned slot reused by AddNode starts with no incoming edges and doesn't
+// silently inherit its previous occupant's edge weights.
+func (g *ADIGraph) clearIncomingEdges(index int) {
+	loc, err := g.GetLocatorsByIndex(index)
+	if err != nil {
+		return
+	}
+	for _, v := range g.nodes {
+		v.RemoveEdges(loc)
+	}
+	g.clearWeightsFor(loc)
+}
+
+// clearWeightsFor removes every weight entry keyed with loc as either endpoint.
+func (g *ADIGraph) clearWeightsFor(loc Locator) {
+	for key := range g.weights {
+		if key.from == loc || key.to == loc {
+			delete(g.weights, key)
+		}
+	}
+}
+
+// indexOfLocator recovers the node index loc addresses, inverting
+// GetLocatorsByIndex's column*wordSize+offset encoding.
+func (g ADIGraph) indexOfLocator(loc Locator) int {
+	return loc.column*g.wordSize + bits.TrailingZeros8(loc.offset)
 }
 
 // Grow adds a new column to the ADI list of every node in the graph. This way, the graph is able to
@@ -211,11 +346,21 @@ func (g ADIGraph) GetLocatorsByLabel(label string) (Locator, error) {
 // DeleteByIndex accepts the integer index of a node within the graph and lazy deletes that node.
 // If the index provided is within the graph, a nil error is returned. If it is not in the graph,
 // an errOutOfBounds is returned.
+//
+// The vacated index is tombstoned: it is pushed onto the graph's free list so the
+// next AddNode reuses the slot instead of letting the node list grow without bound.
 func (g *ADIGraph) DeleteByIndex(index int) error {
 	// Get the node's label and flag it in the labels map.
 	n, _ := g.GetByIndex(index)
+	if n.Deleted() {
+		// Already tombstoned: index is already on the free list (or has
+		// already been reused), so re-pushing it here would let two AddNode
+		// calls pop the same slot and clobber each other's node.
+		return nil
+	}
 	name := n.Label()
 	g.labels[name] = -1
+	delete(g.slots, g.ids[index])
 
 	// Lazy delete.
 	n.Delete()
@@ -227,9 +372,191 @@ func (g *ADIGraph) DeleteByIndex(index int) error {
 		n.RemoveEdges(locs)
 	}
 
+	g.freeList = append(g.freeList, index)
+
 	return nil
 }
 
+// GetByID accepts a stable NodeID and returns the node currently holding it.
+// Unlike GetByIndex, the returned node is reachable the same way across calls to
+// Compact, which otherwise shifts nodes to new slice positions.
+func (g ADIGraph) GetByID(id NodeID) (ADINode, error) {
+	index, ok := g.slots[id]
+	if !ok {
+		return nil, errDeleted
+	}
+	return g.GetByIndex(index)
+}
+
+// Compact shifts the remaining nodes down to eliminate holes left by deleted
+// nodes, reclaiming the entire free list in one pass. It rewrites the labels map,
+// the NodeID<->slot indices, and every remaining node's ADI bytes column-by-column
+// so bit i continues to address the node now living at slice position i.
+//
+// Compact invalidates any Locator obtained before the call; re-derive locators
+// afterwards with GetLocatorsByIndex/GetLocatorsByLabel. NodeIDs remain valid.
+func (g *ADIGraph) Compact() {
+	if len(g.freeList) == 0 {
+		return
+	}
+
+	permutation := make(map[int]int, len(g.nodes)) // old index -> new index
+	live := make([]ADINode, 0, len(g.nodes))
+	liveIDs := make([]NodeID, 0, len(g.nodes))
+
+	for oldIndex, n := range g.nodes {
+		if n == nil || n.Deleted() {
+			continue
+		}
+		permutation[oldIndex] = len(live)
+		live = append(live, n)
+		liveIDs = append(liveIDs, g.ids[oldIndex])
+	}
+
+	for _, n := range live {
+		// LabeledADINode.RemoveEdges wipes byTarget/byLabel for whatever locator
+		// it clears, and rewriteBits clears every old bit before re-adding the
+		// permuted ones via the unlabeled AddEdges - so a label snapshot has to
+		// be taken before rewriteBits runs and re-applied at the new locators
+		// after, or Compact silently drops every edge label.
+		var labels map[int][]Label
+		if ln, ok := n.(LabeledADINode); ok {
+			labels = snapshotLabels(ln, g.wordSize)
+		}
+
+		rewriteBits(n, permutation, g.wordSize)
+
+		if ln, ok := n.(LabeledADINode); ok {
+			restoreLabels(ln, labels, permutation, g.wordSize)
+		}
+	}
+	g.permuteWeights(permutation)
+
+	g.nodes = live
+	g.ids = liveIDs
+	g.freeList = nil
+
+	g.labels = make(map[string]int, len(live))
+	g.slots = make(map[NodeID]int, len(live))
+	for i, n := range live {
+		g.labels[n.Label()] = i
+		g.slots[liveIDs[i]] = i
+	}
+}
+
+// permuteWeights rewrites g.weights to use the post-Compact locators given by
+// permutation (old index -> new index), dropping any entry whose source or
+// target belonged to a node that didn't survive compaction. Without this,
+// weights stay keyed to the old locators and silently attach to whatever
+// happens to occupy that bit position after the shift.
+func (g *ADIGraph) permuteWeights(permutation map[int]int) {
+	if len(g.weights) == 0 {
+		return
+	}
+
+	rewritten := make(map[edgeKey]float64, len(g.weights))
+	for key, w := range g.weights {
+		fromNew, ok := permutation[g.indexOfLocator(key.from)]
+		if !ok {
+			continue
+		}
+		toNew, ok := permutation[g.indexOfLocator(key.to)]
+		if !ok {
+			continue
+		}
+
+		fromLoc, err := g.GetLocatorsByIndex(fromNew)
+		if err != nil {
+			continue
+		}
+		toLoc, err := g.GetLocatorsByIndex(toNew)
+		if err != nil {
+			continue
+		}
+
+		rewritten[edgeKey{from: fromLoc, to: toLoc}] = w
+	}
+	g.weights = rewritten
+}
+
+// rewriteBits rebuilds n's ADI bits in place according to permutation, which maps
+// an old bit position to the new one it should occupy. A bit position with no
+// entry in permutation belonged to a deleted node and is dropped.
+func rewriteBits(n ADINode, permutation map[int]int, wordSize int) {
+	old := append([]byte(nil), n.Edges()...)
+
+	for col, adi := range old {
+		for offset := 0; offset < wordSize; offset++ {
+			bit := byte(1) << byte(offset)
+			if adi&bit != 0 {
+				n.RemoveEdges(Locator{column: col, offset: bit})
+			}
+		}
+	}
+
+	for col, adi := range old {
+		for offset := 0; offset < wordSize; offset++ {
+			bit := byte(1) << byte(offset)
+			if adi&bit == 0 {
+				continue
+			}
+
+			newIndex, ok := permutation[col*wordSize+offset]
+			if !ok {
+				continue
+			}
+
+			newCol, newOffset := newIndex/wordSize, newIndex%wordSize
+			if newCol >= len(old) {
+				continue
+			}
+			n.AddEdges(Locator{column: newCol, offset: 1 << byte(newOffset)})
+		}
+	}
+}
+
+// snapshotLabels records the labels n carries on each of its currently-set
+// bits, keyed by old bit index (col*wordSize+offset), so they can be
+// re-applied at the permuted locator after rewriteBits clears them.
+func snapshotLabels(n LabeledADINode, wordSize int) map[int][]Label {
+	snapshot := make(map[int][]Label)
+	for col, adi := range n.Edges() {
+		for offset := 0; offset < wordSize; offset++ {
+			bit := byte(1) << byte(offset)
+			if adi&bit == 0 {
+				continue
+			}
+			oldIndex := col*wordSize + offset
+			if labels := n.LabelsTo(Locator{column: col, offset: bit}); len(labels) > 0 {
+				snapshot[oldIndex] = labels
+			}
+		}
+	}
+	return snapshot
+}
+
+// restoreLabels re-applies a snapshot taken by snapshotLabels at each bit's new
+// locator after rewriteBits has permuted n's ADI bits. Bit positions with no
+// entry in permutation belonged to a deleted node and are dropped, same as
+// rewriteBits itself.
+func restoreLabels(n LabeledADINode, snapshot map[int][]Label, permutation map[int]int, wordSize int) {
+	numCols := len(n.Edges())
+	for oldIndex, labels := range snapshot {
+		newIndex, ok := permutation[oldIndex]
+		if !ok {
+			continue
+		}
+		newCol, newOffset := newIndex/wordSize, newIndex%wordSize
+		if newCol >= numCols {
+			continue
+		}
+		loc := Locator{column: newCol, offset: 1 << byte(newOffset)}
+		for _, label := range labels {
+			n.AddLabeledEdge(loc, label)
+		}
+	}
+}
+
 // DeleteByLabel accepts an identifier string and lazy deletes the node labeled with that identifier.
 // If the label is found, returns a nil error. If not, returns errLabelNotFound.
 func (g *ADIGraph) DeleteByLabel(label string) error {
@@ -0,0 +1,230 @@
+package adigo
+
+import "testing"
+
+// newTestNode returns a labeled Box with one column pre-allocated. ADIGraph.Grow
+// only runs once the graph crosses a word-size boundary (see AddNode), so a
+// graph with fewer nodes than that never gets a column to store edges in;
+// tests that need edges within a single column seed one up front instead of
+// relying on growth timing that doesn't concern them.
+func newTestNode(label string) *Box {
+	b := &Box{}
+	b.SetLabel(label)
+	b.AddColumn()
+	return b
+}
+
+func TestWeightIsPerEdgeNotPerTarget(t *testing.T) {
+	g := NewGraph()
+	a, b, c := newTestNode("A"), newTestNode("B"), newTestNode("C")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+	g.Connect("A", "C")
+	g.Connect("B", "C")
+
+	aLoc, _ := g.GetLocatorsByLabel("A")
+	bLoc, _ := g.GetLocatorsByLabel("B")
+	cLoc, _ := g.GetLocatorsByLabel("C")
+
+	if err := g.SetWeight(aLoc, cLoc, 5); err != nil {
+		t.Fatalf("SetWeight(A, C, 5) returned error: %v", err)
+	}
+	if err := g.SetWeight(bLoc, cLoc, 2); err != nil {
+		t.Fatalf("SetWeight(B, C, 2) returned error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		from, to Locator
+		want     float64
+	}{
+		{"A to C keeps its own weight", aLoc, cLoc, 5},
+		{"B to C keeps its own weight", bLoc, cLoc, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.Weight(tt.from, tt.to); got != tt.want {
+				t.Errorf("Weight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightDefaultsToOne(t *testing.T) {
+	g := NewGraph()
+	a, b := newTestNode("A"), newTestNode("B")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.Connect("A", "B")
+
+	aLoc, _ := g.GetLocatorsByLabel("A")
+	bLoc, _ := g.GetLocatorsByLabel("B")
+
+	if got := g.Weight(aLoc, bLoc); got != 1 {
+		t.Errorf("Weight() on an unset edge = %v, want 1", got)
+	}
+}
+
+func TestAddNodeReuseClearsStaleWeights(t *testing.T) {
+	g := NewGraph()
+	a, b, c := newTestNode("A"), newTestNode("B"), newTestNode("C")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+	g.Connect("A", "B")
+
+	aLoc, _ := g.GetLocatorsByLabel("A")
+	bLoc, _ := g.GetLocatorsByLabel("B")
+	if err := g.SetWeight(aLoc, bLoc, 7); err != nil {
+		t.Fatalf("SetWeight returned error: %v", err)
+	}
+
+	bIndex, ok := g.labels["B"]
+	if !ok {
+		t.Fatal("could not find B's index")
+	}
+	if err := g.DeleteByIndex(bIndex); err != nil {
+		t.Fatalf("DeleteByIndex returned error: %v", err)
+	}
+
+	// AddNode should reuse B's freed slot, since no Compact has run yet.
+	d := newTestNode("D")
+	if _, err := g.AddNode(d); err != nil {
+		t.Fatalf("AddNode returned error: %v", err)
+	}
+	g.Connect("A", "D")
+
+	dLoc, _ := g.GetLocatorsByLabel("D")
+	if got := g.Weight(aLoc, dLoc); got != 1 {
+		t.Errorf("Weight(A, D) on a reused slot = %v, want 1 (D should not inherit B's old weight)", got)
+	}
+}
+
+func TestCompactMigratesWeights(t *testing.T) {
+	g := NewGraph()
+	a, b, c := newTestNode("A"), newTestNode("B"), newTestNode("C")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+	g.Connect("A", "C")
+
+	aLoc, _ := g.GetLocatorsByLabel("A")
+	cLoc, _ := g.GetLocatorsByLabel("C")
+	if err := g.SetWeight(aLoc, cLoc, 9); err != nil {
+		t.Fatalf("SetWeight returned error: %v", err)
+	}
+
+	bIndex, ok := g.labels["B"]
+	if !ok {
+		t.Fatal("could not find B's index")
+	}
+	if err := g.DeleteByIndex(bIndex); err != nil {
+		t.Fatalf("DeleteByIndex returned error: %v", err)
+	}
+
+	g.Compact()
+
+	aLoc, _ = g.GetLocatorsByLabel("A")
+	cLoc, _ = g.GetLocatorsByLabel("C")
+	if got, want := g.Weight(aLoc, cLoc), 9.0; got != want {
+		t.Errorf("Weight(A, C) after Compact = %v, want %v (weight should follow C to its new slot)", got, want)
+	}
+}
+
+func TestSetWeightRejectsNegative(t *testing.T) {
+	g := NewGraph()
+	a, b := newTestNode("A"), newTestNode("B")
+	g.AddNode(a)
+	g.AddNode(b)
+
+	aLoc, _ := g.GetLocatorsByLabel("A")
+	bLoc, _ := g.GetLocatorsByLabel("B")
+
+	if err := g.SetWeight(aLoc, bLoc, -1); err != errNegativeWeight {
+		t.Errorf("SetWeight() with a negative weight returned %v, want errNegativeWeight", err)
+	}
+}
+
+func newTestLabeledBox(label string) *LabeledBox {
+	n := &LabeledBox{}
+	n.SetLabel(label)
+	n.AddColumn()
+	return n
+}
+
+func TestCompactCarriesLabelsAcrossLabeledNodes(t *testing.T) {
+	g := NewGraph()
+	a, b, c := newTestLabeledBox("A"), newTestLabeledBox("B"), newTestLabeledBox("C")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+
+	cLoc, _ := g.GetLocatorsByLabel("C")
+	a.AddLabeledEdge(cLoc, "calls")
+
+	bIndex, ok := g.labels["B"]
+	if !ok {
+		t.Fatal("could not find B's index")
+	}
+	if err := g.DeleteByIndex(bIndex); err != nil {
+		t.Fatalf("DeleteByIndex returned error: %v", err)
+	}
+
+	// Compact shifts C down into B's old slot.
+	g.Compact()
+
+	cLoc, _ = g.GetLocatorsByLabel("C")
+	if !a.HasEdges(true, cLoc) {
+		t.Fatalf("HasEdges(A, C) after Compact = false, want true")
+	}
+	labels := a.LabelsTo(cLoc)
+	if len(labels) != 1 || labels[0] != "calls" {
+		t.Errorf("LabelsTo(A, C) after Compact = %v, want [calls] (label should follow C to its new slot)", labels)
+	}
+}
+
+func TestDeleteByIndexTwiceIsANoOp(t *testing.T) {
+	g := NewGraph()
+	a, b, c := newTestNode("A"), newTestNode("B"), newTestNode("C")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+
+	bIndex, ok := g.labels["B"]
+	if !ok {
+		t.Fatal("could not find B's index")
+	}
+	if err := g.DeleteByIndex(bIndex); err != nil {
+		t.Fatalf("first DeleteByIndex returned error: %v", err)
+	}
+	if err := g.DeleteByIndex(bIndex); err != nil {
+		t.Fatalf("second DeleteByIndex on an already-tombstoned index returned error: %v", err)
+	}
+
+	if want, got := 1, len(g.freeList); got != want {
+		t.Fatalf("freeList = %v, want exactly one entry (double-delete must not push index twice)", g.freeList)
+	}
+
+	d := newTestNode("D")
+	if _, err := g.AddNode(d); err != nil {
+		t.Fatalf("AddNode(D) returned error: %v", err)
+	}
+	e := newTestNode("E")
+	if _, err := g.AddNode(e); err != nil {
+		t.Fatalf("AddNode(E) returned error: %v", err)
+	}
+
+	dIndex, ok := g.labels["D"]
+	if !ok {
+		t.Fatal("could not find D's index")
+	}
+	eIndex, ok := g.labels["E"]
+	if !ok {
+		t.Fatal("could not find E's index")
+	}
+	if dIndex == eIndex {
+		t.Errorf("D and E both resolved to index %d, want distinct slots", dIndex)
+	}
+}
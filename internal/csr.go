@@ -0,0 +1,152 @@
+package adigo
+
+// CSRGraph is a read-oriented alternative to ADIGraph that stores connectivity
+// as three flat slices in compressed sparse row (CSR) format, rather than a
+// per-node ADI bitset. Where ADIGraph.Neighbors spawns a goroutine per bit of
+// every column on every call - fine for a small, actively-mutated graph, but
+// wasteful once the graph is large and read-mostly - CSRGraph gives contiguous,
+// cache-friendly neighbor iteration with no goroutine overhead at all.
+//
+// CSRGraph has no mutation API. Build one from a finished ADIGraph with
+// FromADIGraph once graph construction is done and the workload shifts to
+// analytics (BFS, PageRank, triangle counting, ...).
+type CSRGraph struct {
+	nodes      []ADINode
+	labels     map[string]int
+	rowPtr     []int32
+	colIdx     []int32
+	edgeWeight []float64
+}
+
+// FromADIGraph builds a CSRGraph snapshot of g. Edge weights are carried over
+// via g.Weight, so edges with no weight set are recorded as 1.
+func FromADIGraph(g ADIGraph) *CSRGraph {
+	n := len(g.nodes)
+
+	c := &CSRGraph{
+		nodes:  append([]ADINode(nil), g.nodes...),
+		labels: make(map[string]int, len(g.labels)),
+		rowPtr: make([]int32, n+1),
+	}
+	// Copy g.labels rather than rebuilding it from each node's Label(): a
+	// tombstoned entry is recorded there as -1 (see DeleteByIndex), and GetByIndex
+	// treats -1 as errDeleted, so a deleted node's old label stays resolvable to
+	// "deleted" in the snapshot instead of resurrecting it.
+	for label, index := range g.labels {
+		c.labels[label] = index
+	}
+
+	for i, node := range c.nodes {
+		c.rowPtr[i] = int32(len(c.colIdx))
+
+		// Delete only flips a deleted flag; it never clears a node's own
+		// outgoing ADI bits (see DeleteByIndex), so a lazily-deleted node's
+		// stale edges must be skipped here rather than walked into colIdx.
+		if node.Deleted() {
+			continue
+		}
+
+		srcLoc, err := g.GetLocatorsByIndex(i)
+		if err != nil {
+			continue
+		}
+
+		for col, adi := range node.Edges() {
+			for offset := 0; offset < g.wordSize; offset++ {
+				if adi&(1<<byte(offset)) == 0 {
+					continue
+				}
+
+				target := col*g.wordSize + offset
+				if target >= n {
+					continue
+				}
+
+				c.colIdx = append(c.colIdx, int32(target))
+				c.edgeWeight = append(c.edgeWeight, g.Weight(srcLoc, Locator{column: col, offset: 1 << byte(offset)}))
+			}
+		}
+	}
+	c.rowPtr[n] = int32(len(c.colIdx))
+
+	return c
+}
+
+// Size returns the number of nodes in the graph.
+func (c *CSRGraph) Size() int {
+	return len(c.nodes)
+}
+
+// GetByIndex accepts an integer index and returns the node at that index within
+// the graph.
+func (c *CSRGraph) GetByIndex(index int) (ADINode, error) {
+	if index < 0 || index >= len(c.nodes) {
+		return nil, errDeleted
+	}
+	return c.nodes[index], nil
+}
+
+// GetByLabel accepts a string identifier and returns the node labelled with that
+// identifier.
+func (c *CSRGraph) GetByLabel(label string) (ADINode, error) {
+	index, ok := c.labels[label]
+	if !ok {
+		return nil, errLabelNotFound
+	}
+	return c.GetByIndex(index)
+}
+
+// Neighbors returns n's neighbors by slicing colIdx at n's row, giving
+// contiguous, cache-friendly iteration instead of the goroutine-per-bit scan
+// ADIGraph.Neighbors performs.
+func (c *CSRGraph) Neighbors(n ADINode) []ADINode {
+	i, ok := c.labels[n.Label()]
+	if !ok {
+		return nil
+	}
+
+	row := c.colIdx[c.rowPtr[i]:c.rowPtr[i+1]]
+	results := make([]ADINode, len(row))
+	for j, idx := range row {
+		results[j] = c.nodes[idx]
+	}
+	return results
+}
+
+// BFS performs a breadth-first search on the CSRGraph starting from node a and
+// checks if node b is reachable.
+func (c *CSRGraph) BFS(a, b ADINode) bool {
+	visited := map[string]bool{a.Label(): true}
+	queue := []ADINode{a}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for _, nb := range c.Neighbors(cur) {
+			if nb.Label() == b.Label() {
+				return true
+			}
+			if !visited[nb.Label()] {
+				visited[nb.Label()] = true
+				queue = append(queue, nb)
+			}
+		}
+	}
+
+	return false
+}
+
+// ForEachEdge calls f once per edge in the graph with the source and
+// destination node indices and the edge's weight, in row order. Because it
+// never touches a shared mutable structure, f can safely be run from multiple
+// goroutines over disjoint row ranges for parallel analytics like PageRank or
+// triangle counting.
+func (c *CSRGraph) ForEachEdge(f func(src, dst int32, w float64)) {
+	for i := range c.nodes {
+		src := int32(i)
+		for e := c.rowPtr[i]; e < c.rowPtr[i+1]; e++ {
+			f(src, c.colIdx[e], c.edgeWeight[e])
+		}
+	}
+}
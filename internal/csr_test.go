@@ -0,0 +1,72 @@
+package adigo
+
+import "testing"
+
+func TestFromADIGraphSkipsDeletedSourceEdges(t *testing.T) {
+	g := NewGraph()
+	a, b, c := newTestNode("A"), newTestNode("B"), newTestNode("C")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+	g.Connect("A", "B")
+	g.Connect("B", "C")
+
+	aIndex, ok := g.labels["A"]
+	if !ok {
+		t.Fatal("could not find A's index")
+	}
+	// Delete only lazy-deletes A: incoming edges into A are cleared, but A's own
+	// outgoing ADI bit to B is left set (see DeleteByIndex).
+	if err := g.DeleteByIndex(aIndex); err != nil {
+		t.Fatalf("DeleteByIndex returned error: %v", err)
+	}
+
+	snapshot := FromADIGraph(g)
+
+	var edgeCount int
+	snapshot.ForEachEdge(func(src, dst int32, w float64) {
+		edgeCount++
+		if int(src) == aIndex {
+			t.Errorf("ForEachEdge reported an edge from deleted node A: %d -> %d", src, dst)
+		}
+	})
+	if want := 1; edgeCount != want {
+		t.Errorf("ForEachEdge reported %d edges, want %d (only B -> C should survive)", edgeCount, want)
+	}
+}
+
+func TestFromADIGraphCarriesPerEdgeWeights(t *testing.T) {
+	g := NewGraph()
+	a, b, c := newTestNode("A"), newTestNode("B"), newTestNode("C")
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+	g.Connect("A", "C")
+	g.Connect("B", "C")
+
+	aLoc, _ := g.GetLocatorsByLabel("A")
+	bLoc, _ := g.GetLocatorsByLabel("B")
+	cLoc, _ := g.GetLocatorsByLabel("C")
+	if err := g.SetWeight(aLoc, cLoc, 5); err != nil {
+		t.Fatalf("SetWeight(A, C, 5) returned error: %v", err)
+	}
+	if err := g.SetWeight(bLoc, cLoc, 2); err != nil {
+		t.Fatalf("SetWeight(B, C, 2) returned error: %v", err)
+	}
+
+	snapshot := FromADIGraph(g)
+
+	got := map[string]float64{}
+	snapshot.ForEachEdge(func(src, dst int32, w float64) {
+		srcNode, _ := snapshot.GetByIndex(int(src))
+		dstNode, _ := snapshot.GetByIndex(int(dst))
+		got[srcNode.Label()+"->"+dstNode.Label()] = w
+	})
+
+	want := map[string]float64{"A->C": 5, "B->C": 2}
+	for edge, w := range want {
+		if got[edge] != w {
+			t.Errorf("weight of %s = %v, want %v", edge, got[edge], w)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package adigo
+
+// Label identifies the kind of relationship an edge represents (e.g. "calls",
+// "contains"), independent of the ADI bit that records raw connectivity.
+type Label string
+
+// LabeledADINode extends ADINode so each edge can additionally carry a Label,
+// while the ADI bit inherited from ADINode remains the fast O(1) connectivity
+// check. Implementations keep a double index - by target and by label -
+// mirroring DLGraph, so "what labels connect to this target?" and "which
+// targets does this label reach?" are both O(1).
+type LabeledADINode interface {
+	ADINode
+	AddLabeledEdge(loc Locator, label Label)
+	RemoveLabeledEdge(loc Locator, label Label)
+	LabelsTo(loc Locator) []Label
+	TargetsByLabel(label Label) []Locator
+}
+
+// LabeledBox is a Box whose edges additionally carry a Label, tracked through a
+// pair of auxiliary maps alongside the embedded Box's ADIs.
+type LabeledBox struct {
+	Box
+	byTarget map[Locator]map[Label]bool
+	byLabel  map[Label]map[Locator]bool
+}
+
+// AddLabeledEdge connects the receiver to the node at loc - setting the
+// underlying ADI bit via AddEdges - and records label as the kind of that edge
+// in both indices.
+func (s *LabeledBox) AddLabeledEdge(loc Locator, label Label) {
+	s.AddEdges(loc)
+
+	if s.byTarget == nil {
+		s.byTarget = make(map[Locator]map[Label]bool)
+	}
+	if s.byTarget[loc] == nil {
+		s.byTarget[loc] = make(map[Label]bool)
+	}
+	s.byTarget[loc][label] = true
+
+	if s.byLabel == nil {
+		s.byLabel = make(map[Label]map[Locator]bool)
+	}
+	if s.byLabel[label] == nil {
+		s.byLabel[label] = make(map[Locator]bool)
+	}
+	s.byLabel[label][loc] = true
+}
+
+// RemoveLabeledEdge drops label from the edge to loc in both indices. Once loc
+// carries no labels at all, the underlying ADI bit is cleared too via
+// RemoveEdges, since an edge with no labels left is no longer an edge.
+func (s *LabeledBox) RemoveLabeledEdge(loc Locator, label Label) {
+	if s.byTarget[loc] != nil {
+		delete(s.byTarget[loc], label)
+		if len(s.byTarget[loc]) == 0 {
+			delete(s.byTarget, loc)
+		}
+	}
+	if s.byLabel[label] != nil {
+		delete(s.byLabel[label], loc)
+		if len(s.byLabel[label]) == 0 {
+			delete(s.byLabel, label)
+		}
+	}
+
+	if _, ok := s.byTarget[loc]; !ok {
+		s.RemoveEdges(loc)
+	}
+}
+
+// LabelsTo returns the labels recorded for the edge to loc.
+func (s *LabeledBox) LabelsTo(loc Locator) []Label {
+	labels := make([]Label, 0, len(s.byTarget[loc]))
+	for label := range s.byTarget[loc] {
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// TargetsByLabel returns every locator this node reaches via an edge carrying
+// label.
+func (s *LabeledBox) TargetsByLabel(label Label) []Locator {
+	targets := make([]Locator, 0, len(s.byLabel[label]))
+	for loc := range s.byLabel[label] {
+		targets = append(targets, loc)
+	}
+	return targets
+}
+
+// RemoveEdges clears the ADI bits for locators, as Box.RemoveEdges does, and
+// also drops any labels recorded against them so both indices stay in sync
+// with the underlying connectivity.
+func (s *LabeledBox) RemoveEdges(locators ...Locator) {
+	s.Box.RemoveEdges(locators...)
+	for _, loc := range locators {
+		for label := range s.byTarget[loc] {
+			if s.byLabel[label] != nil {
+				delete(s.byLabel[label], loc)
+				if len(s.byLabel[label]) == 0 {
+					delete(s.byLabel, label)
+				}
+			}
+		}
+		delete(s.byTarget, loc)
+	}
+}
+
+// Delete lazy-deletes the node, as Box.Delete does, and drops every label it
+// recorded, since a deleted node's edges no longer mean anything.
+func (s *LabeledBox) Delete() {
+	s.Box.Delete()
+	s.byTarget = nil
+	s.byLabel = nil
+}
@@ -0,0 +1,73 @@
+package adigo
+
+import "testing"
+
+func newTestLabeledNode(label string) *LabeledBox {
+	n := &LabeledBox{}
+	n.SetLabel(label)
+	n.AddColumn()
+	return n
+}
+
+func TestRemoveEdgesSyncsLabelIndex(t *testing.T) {
+	n := newTestLabeledNode("A")
+	loc := Locator{column: 0, offset: 1}
+
+	n.AddLabeledEdge(loc, "calls")
+	n.AddLabeledEdge(loc, "contains")
+
+	n.RemoveEdges(loc)
+
+	if labels := n.LabelsTo(loc); len(labels) != 0 {
+		t.Errorf("LabelsTo(loc) after RemoveEdges = %v, want none", labels)
+	}
+	if targets := n.TargetsByLabel("calls"); len(targets) != 0 {
+		t.Errorf("TargetsByLabel(\"calls\") after RemoveEdges = %v, want none", targets)
+	}
+	if targets := n.TargetsByLabel("contains"); len(targets) != 0 {
+		t.Errorf("TargetsByLabel(\"contains\") after RemoveEdges = %v, want none", targets)
+	}
+	if n.HasEdges(true, loc) {
+		t.Error("HasEdges(loc) after RemoveEdges = true, want false")
+	}
+}
+
+func TestRemoveLabeledEdgeKeepsOtherLabels(t *testing.T) {
+	n := newTestLabeledNode("A")
+	loc := Locator{column: 0, offset: 1}
+
+	n.AddLabeledEdge(loc, "calls")
+	n.AddLabeledEdge(loc, "contains")
+
+	n.RemoveLabeledEdge(loc, "calls")
+
+	if labels := n.LabelsTo(loc); len(labels) != 1 || labels[0] != "contains" {
+		t.Errorf("LabelsTo(loc) after removing one of two labels = %v, want [contains]", labels)
+	}
+	if !n.HasEdges(true, loc) {
+		t.Error("HasEdges(loc) after removing one of two labels = false, want true (edge still carries \"contains\")")
+	}
+
+	n.RemoveLabeledEdge(loc, "contains")
+	if n.HasEdges(true, loc) {
+		t.Error("HasEdges(loc) after removing last label = true, want false")
+	}
+}
+
+func TestDeleteClearsLabelIndex(t *testing.T) {
+	n := newTestLabeledNode("A")
+	loc := Locator{column: 0, offset: 1}
+
+	n.AddLabeledEdge(loc, "calls")
+	n.Delete()
+
+	if labels := n.LabelsTo(loc); len(labels) != 0 {
+		t.Errorf("LabelsTo(loc) after Delete = %v, want none", labels)
+	}
+	if targets := n.TargetsByLabel("calls"); len(targets) != 0 {
+		t.Errorf("TargetsByLabel(\"calls\") after Delete = %v, want none", targets)
+	}
+	if !n.Deleted() {
+		t.Error("Deleted() after Delete = false, want true")
+	}
+}